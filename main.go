@@ -2,11 +2,14 @@ package main
 
 import (
 	"bufio"
+	"context"
 	"crypto/tls"
+	"encoding/csv"
 	"encoding/json"
 	"flag"
 	"fmt"
 	"io"
+	"math/rand"
 	"net/http"
 	"net/url"
 	"os"
@@ -22,28 +25,51 @@ type Job struct {
 	URL      string
 	PostData string
 	Depth    int
+	Values   map[string]string // keyword -> word bound for this job, e.g. {"FUZZ": "admin", "PASS": "hunter2"}
 }
 
+// WordlistBinding binds a wordlist file to the keyword it fills in, e.g. -w users.txt:USER.
+type WordlistBinding struct {
+	Path    string
+	Keyword string
+}
+
+// Iteration modes for combining multiple bound wordlists into jobs.
+const (
+	ModeSniper      = "sniper"
+	ModePitchfork   = "pitchfork"
+	ModeClusterbomb = "clusterbomb"
+)
+
 // Result represents the outcome of a fuzz request
 type Result struct {
 	URL        string `json:"url"`
 	StatusCode int    `json:"status_code"`
 	Length     int    `json:"length"`
+	Words      int    `json:"words"`
+	Lines      int    `json:"lines"`
+	DurationMs int64  `json:"duration_ms"`
 	Error      string `json:"error,omitempty"`
 }
 
+// Baseline is a recorded response signature used to recognize wildcard/soft-404 responses.
+type Baseline struct {
+	StatusCode int
+	Length     int
+	Words      int
+	Lines      int
+	Time       time.Duration
+}
+
 type Config struct {
 	URLTemplate     string
-	Wordlist        string
+	Wordlists       []WordlistBinding
+	Mode            string
 	Method          string
 	Headers         map[string]string
 	Extensions      []string
 	Workers         int
-	MinLength       int
-	MaxLength       int
 	Recursive       bool
-	StatusFilter    []int
-	RegexFilter     *regexp.Regexp
 	PostData        string
 	FollowRedirects bool
 	Timeout         time.Duration
@@ -51,149 +77,1047 @@ type Config struct {
 	Proxy           string
 	UseTor          bool
 	MaxDepth        int
-	JSONOutput      bool
+	OutputFormat    string
+	OutputPath      string
 	Retries         int
+
+	AutoCalibrate        bool
+	AutoCalibrateCount   int
+	AutoCalibratePerHost bool
+	AutoCalibrateStrings []string
+
+	MaxRunTime time.Duration
+	MaxJobTime time.Duration
+
+	Matchers   FilterSpec
+	Filters    FilterSpec
+	MatchMode  string
+	FilterMode string
+
+	ReplayProxy string
 }
 
-// replace FUZZ placeholder
-func replacePlaceholder(template, word string) string {
-	return strings.ReplaceAll(template, "FUZZ", word)
+// intRange is an inclusive [Min, Max] range; a bare value N parses as {N, N}.
+type intRange struct {
+	Min, Max int
 }
 
-func containsInt(slice []int, val int) bool {
-	for _, v := range slice {
-		if v == val {
+func parseIntRanges(s string) ([]intRange, error) {
+	var ranges []intRange
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		if idx := strings.Index(part, "-"); idx > 0 {
+			lo, errLo := strconv.Atoi(strings.TrimSpace(part[:idx]))
+			hi, errHi := strconv.Atoi(strings.TrimSpace(part[idx+1:]))
+			if errLo != nil || errHi != nil {
+				return nil, fmt.Errorf("invalid range %q", part)
+			}
+			ranges = append(ranges, intRange{Min: lo, Max: hi})
+			continue
+		}
+		v, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value %q", part)
+		}
+		ranges = append(ranges, intRange{Min: v, Max: v})
+	}
+	return ranges, nil
+}
+
+func intInRanges(v int, ranges []intRange) bool {
+	for _, r := range ranges {
+		if v >= r.Min && v <= r.Max {
 			return true
 		}
 	}
 	return false
 }
 
-// Worker
-func worker(jobs chan Job, results chan Result, cfg *Config, client *http.Client, wg *sync.WaitGroup, visited *sync.Map) {
-	defer wg.Done()
-	for job := range jobs {
-		// Avoid revisiting
-		if cfg.Recursive {
-			if _, loaded := visited.LoadOrStore(job.URL, true); loaded {
-				continue
+// FilterSpec is one dimension-set of a matcher or filter: the result-selection
+// criteria for status codes, size, word count, line count, a body regex, and a
+// minimum response time, each optional and independently configurable.
+type FilterSpec struct {
+	Codes   []intRange
+	Sizes   []intRange
+	Words   []intRange
+	Lines   []intRange
+	Regex   *regexp.Regexp
+	MinTime time.Duration
+}
+
+func (f FilterSpec) isEmpty() bool {
+	return len(f.Codes) == 0 && len(f.Sizes) == 0 && len(f.Words) == 0 && len(f.Lines) == 0 && f.Regex == nil && f.MinTime == 0
+}
+
+// evaluate reports, for each dimension configured in f, whether the response matched it.
+func (f FilterSpec) evaluate(statusCode, size, words, lines int, body []byte, duration time.Duration) []bool {
+	var results []bool
+	if len(f.Codes) > 0 {
+		results = append(results, intInRanges(statusCode, f.Codes))
+	}
+	if len(f.Sizes) > 0 {
+		results = append(results, intInRanges(size, f.Sizes))
+	}
+	if len(f.Words) > 0 {
+		results = append(results, intInRanges(words, f.Words))
+	}
+	if len(f.Lines) > 0 {
+		results = append(results, intInRanges(lines, f.Lines))
+	}
+	if f.Regex != nil {
+		results = append(results, f.Regex.Match(body))
+	}
+	if f.MinTime > 0 {
+		results = append(results, duration >= f.MinTime)
+	}
+	return results
+}
+
+// parseFilterSpec builds a FilterSpec from the comma-separated -mc/-ms/-mw/-ml/-mr style
+// flag values (or their -f counterparts); minTime is threaded through from the paired
+// -mt/-ft flag, which flag.DurationVar already parses directly into the spec.
+func parseFilterSpec(codes, sizes, words, lines, regex string, minTime time.Duration) (FilterSpec, error) {
+	spec := FilterSpec{MinTime: minTime}
+	var err error
+	if codes != "" {
+		if spec.Codes, err = parseIntRanges(codes); err != nil {
+			return spec, err
+		}
+	}
+	if sizes != "" {
+		if spec.Sizes, err = parseIntRanges(sizes); err != nil {
+			return spec, err
+		}
+	}
+	if words != "" {
+		if spec.Words, err = parseIntRanges(words); err != nil {
+			return spec, err
+		}
+	}
+	if lines != "" {
+		if spec.Lines, err = parseIntRanges(lines); err != nil {
+			return spec, err
+		}
+	}
+	if regex != "" {
+		if spec.Regex, err = regexp.Compile(regex); err != nil {
+			return spec, err
+		}
+	}
+	return spec, nil
+}
+
+// combine folds per-dimension results into one verdict using "and" or "or" mode.
+func combine(results []bool, mode string) bool {
+	if len(results) == 0 {
+		return false
+	}
+	if mode == "or" {
+		for _, r := range results {
+			if r {
+				return true
 			}
 		}
+		return false
+	}
+	for _, r := range results {
+		if !r {
+			return false
+		}
+	}
+	return true
+}
+
+// replacePlaceholders substitutes every bound keyword in template with its value.
+func replacePlaceholders(template string, values map[string]string) string {
+	out := template
+	for keyword, word := range values {
+		out = strings.ReplaceAll(out, keyword, word)
+	}
+	return out
+}
+
+func cloneValues(values map[string]string) map[string]string {
+	out := make(map[string]string, len(values))
+	for k, v := range values {
+		out[k] = v
+	}
+	return out
+}
+
+// wordlistFlag collects repeatable -w path[:KEYWORD] flags into WordlistBindings.
+// A value with no ":KEYWORD" suffix binds to FUZZ, matching the original single-wordlist behavior.
+type wordlistFlag []WordlistBinding
+
+func (w *wordlistFlag) String() string {
+	parts := make([]string, len(*w))
+	for i, b := range *w {
+		parts[i] = b.Path + ":" + b.Keyword
+	}
+	return strings.Join(parts, ",")
+}
+
+func (w *wordlistFlag) Set(value string) error {
+	path, keyword := value, "FUZZ"
+	if idx := strings.LastIndex(value, ":"); idx != -1 {
+		path, keyword = value[:idx], value[idx+1:]
+	}
+	*w = append(*w, WordlistBinding{Path: path, Keyword: keyword})
+	return nil
+}
+
+func countWords(body []byte) int {
+	return len(strings.Fields(string(body)))
+}
+
+func countLines(body []byte) int {
+	if len(body) == 0 {
+		return 0
+	}
+	return strings.Count(string(body), "\n") + 1
+}
 
-		var bodyReader io.Reader
-		if job.PostData != "" {
-			bodyReader = strings.NewReader(job.PostData)
+const randomWordChars = "abcdefghijklmnopqrstuvwxyz0123456789"
+
+// randomWord generates a pseudo-random alphanumeric string used as a calibration probe.
+func randomWord(n int) string {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = randomWordChars[rand.Intn(len(randomWordChars))]
+	}
+	return string(b)
+}
+
+// calibrationWords returns the probe words used to fingerprint wildcard responses,
+// preferring user-supplied -acs strings over randomly generated ones.
+func calibrationWords(cfg *Config) []string {
+	if len(cfg.AutoCalibrateStrings) > 0 {
+		return cfg.AutoCalibrateStrings
+	}
+	words := make([]string, cfg.AutoCalibrateCount)
+	for i := range words {
+		words[i] = randomWord(10)
+	}
+	return words
+}
+
+// calibrationValues returns one Values binding per calibration round, substituting the
+// round's probe word for every bound keyword (not just FUZZ), matching how buildJob
+// resolves a real job's Values.
+func calibrationValues(cfg *Config) []map[string]string {
+	rounds := make([]map[string]string, 0, cfg.AutoCalibrateCount)
+	for _, word := range calibrationWords(cfg) {
+		values := make(map[string]string, len(cfg.Wordlists))
+		for _, binding := range cfg.Wordlists {
+			values[binding.Keyword] = word
+		}
+		if len(cfg.Wordlists) == 0 {
+			values["FUZZ"] = word
 		}
+		rounds = append(rounds, values)
+	}
+	return rounds
+}
 
-		var resp *http.Response
-		var err error
-		for attempt := 0; attempt <= cfg.Retries; attempt++ {
-			req, e := http.NewRequest(cfg.Method, job.URL, bodyReader)
-			if e != nil {
-				err = e
-				continue
-			}
+// fetchBaseline issues a single calibration request against targetURL, substituting values
+// into the headers and POST data the same way processJob does for a real job, and records
+// its response signature.
+func fetchBaseline(ctx context.Context, cfg *Config, client *http.Client, targetURL string, values map[string]string) (Baseline, bool) {
+	var bodyReader io.Reader
+	postData := replacePlaceholders(cfg.PostData, values)
+	if postData != "" {
+		bodyReader = strings.NewReader(postData)
+	}
+	req, err := http.NewRequestWithContext(ctx, cfg.Method, targetURL, bodyReader)
+	if err != nil {
+		return Baseline{}, false
+	}
+	for k, v := range cfg.Headers {
+		req.Header.Set(k, replacePlaceholders(v, values))
+	}
 
-			for k, v := range cfg.Headers {
-				req.Header.Set(k, replacePlaceholder(v, job.URL))
-			}
+	start := time.Now()
+	resp, err := client.Do(req)
+	if err != nil {
+		return Baseline{}, false
+	}
+	defer resp.Body.Close()
+	dur := time.Since(start)
 
-			resp, err = client.Do(req)
-			if err == nil {
-				break
-			}
-			time.Sleep(500 * time.Millisecond) // retry delay
+	body, _ := io.ReadAll(resp.Body)
+	return Baseline{
+		StatusCode: resp.StatusCode,
+		Length:     len(body),
+		Words:      countWords(body),
+		Lines:      countLines(body),
+		Time:       dur,
+	}, true
+}
+
+// calibrate fingerprints wildcard/soft-404 responses by requesting urlTemplate with
+// nonsense words substituted for every bound keyword, so they can later be suppressed
+// from results.
+func calibrate(ctx context.Context, cfg *Config, client *http.Client, urlTemplate string) []Baseline {
+	var baselines []Baseline
+	for _, values := range calibrationValues(cfg) {
+		targetURL := replacePlaceholders(urlTemplate, values)
+		if b, ok := fetchBaseline(ctx, cfg, client, targetURL, values); ok {
+			baselines = append(baselines, b)
 		}
+	}
+	return baselines
+}
 
-		if err != nil {
-			results <- Result{URL: job.URL, Error: err.Error()}
-			continue
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// calibrationTolerance is how far a result's counts may drift from a baseline and still be
+// considered the same wildcard response.
+const calibrationTolerance = 2
+
+func matchesBaseline(statusCode, length, words, lines int, baselines []Baseline) bool {
+	for _, b := range baselines {
+		if statusCode == b.StatusCode &&
+			abs(length-b.Length) <= calibrationTolerance &&
+			abs(words-b.Words) <= calibrationTolerance &&
+			abs(lines-b.Lines) <= calibrationTolerance {
+			return true
 		}
+	}
+	return false
+}
 
-		body, _ := io.ReadAll(resp.Body)
-		resp.Body.Close()
-		length := len(body)
+// hostCalibration lazily holds the autocalibration baselines for a single host, computed
+// once regardless of how many workers race to request it.
+type hostCalibration struct {
+	once      sync.Once
+	baselines []Baseline
+}
 
-		// Filters
-		if len(cfg.StatusFilter) > 0 && !containsInt(cfg.StatusFilter, resp.StatusCode) {
-			continue
+// baselinesForHost returns the calibration baselines for host, calibrating it on first use
+// against cfg.URLTemplate with only the host swapped, so the baseline is fetched from the
+// same path/query the real fuzzing requests use.
+func baselinesForHost(ctx context.Context, cfg *Config, client *http.Client, cache *sync.Map, host string) []Baseline {
+	v, _ := cache.LoadOrStore(host, &hostCalibration{})
+	hc := v.(*hostCalibration)
+	hc.once.Do(func() {
+		target := cfg.URLTemplate
+		if u, err := url.Parse(cfg.URLTemplate); err == nil {
+			u.Host = host
+			target = u.String()
 		}
-		if (cfg.MinLength > 0 && length < cfg.MinLength) || (cfg.MaxLength > 0 && length > cfg.MaxLength) {
-			continue
+		hc.baselines = calibrate(ctx, cfg, client, target)
+	})
+	return hc.baselines
+}
+
+// sendJob enqueues job, unless ctx is cancelled first (e.g. -maxtime expired), in which
+// case it reports false so the caller can stop feeding without blocking forever.
+func sendJob(ctx context.Context, jobs chan Job, job Job) bool {
+	select {
+	case jobs <- job:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// Worker
+func worker(jobs chan Job, results chan Result, cfg *Config, client, replayClient *http.Client, wg *sync.WaitGroup, visited *sync.Map, baselines []Baseline, hostCalibCache *sync.Map, ctx context.Context) {
+	defer wg.Done()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case job, ok := <-jobs:
+			if !ok {
+				return
+			}
+			processJob(jobs, results, cfg, client, replayClient, visited, baselines, hostCalibCache, ctx, job)
 		}
-		if cfg.RegexFilter != nil && !cfg.RegexFilter.Match(body) {
+	}
+}
+
+// processJob performs a single fuzz request, applies filters, and queues any recursion jobs.
+func processJob(jobs chan Job, results chan Result, cfg *Config, client, replayClient *http.Client, visited *sync.Map, baselines []Baseline, hostCalibCache *sync.Map, ctx context.Context, job Job) {
+	// Avoid revisiting
+	if cfg.Recursive {
+		if _, loaded := visited.LoadOrStore(job.URL, true); loaded {
+			return
+		}
+	}
+
+	var bodyReader io.Reader
+	if job.PostData != "" {
+		bodyReader = strings.NewReader(job.PostData)
+	}
+
+	reqCtx := ctx
+	if cfg.MaxJobTime > 0 {
+		var cancel context.CancelFunc
+		reqCtx, cancel = context.WithTimeout(ctx, cfg.MaxJobTime)
+		defer cancel()
+	}
+
+	var resp *http.Response
+	var err error
+	var duration time.Duration
+	for attempt := 0; attempt <= cfg.Retries; attempt++ {
+		req, e := http.NewRequestWithContext(reqCtx, cfg.Method, job.URL, bodyReader)
+		if e != nil {
+			err = e
 			continue
 		}
 
-		results <- Result{
-			URL:        job.URL,
-			StatusCode: resp.StatusCode,
-			Length:     length,
+		for k, v := range cfg.Headers {
+			req.Header.Set(k, replacePlaceholders(v, job.Values))
 		}
 
-		// Recursive fuzzing
-		if cfg.Recursive && job.Depth < cfg.MaxDepth {
-			for _, ext := range cfg.Extensions {
-				newJob := Job{
-					URL:      replacePlaceholder(cfg.URLTemplate, job.URL+ext),
-					PostData: replacePlaceholder(cfg.PostData, job.URL+ext),
-					Depth:    job.Depth + 1,
-				}
-				jobs <- newJob
+		start := time.Now()
+		resp, err = client.Do(req)
+		duration = time.Since(start)
+		if err == nil {
+			break
+		}
+		if reqCtx.Err() != nil {
+			break // -maxtime/-maxtime-job expired; stop retrying and drain
+		}
+		time.Sleep(500 * time.Millisecond) // retry delay
+	}
+
+	if err != nil {
+		results <- Result{URL: job.URL, Error: err.Error()}
+		return
+	}
+
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	length := len(body)
+	words := countWords(body)
+	lines := countLines(body)
+
+	// Matchers: must match to show. Filters: must not match to show.
+	if !cfg.Matchers.isEmpty() && !combine(cfg.Matchers.evaluate(resp.StatusCode, length, words, lines, body, duration), cfg.MatchMode) {
+		return
+	}
+	if !cfg.Filters.isEmpty() && combine(cfg.Filters.evaluate(resp.StatusCode, length, words, lines, body, duration), cfg.FilterMode) {
+		return
+	}
+
+	if cfg.AutoCalibrate {
+		activeBaselines := baselines
+		if cfg.AutoCalibratePerHost {
+			if u, err := url.Parse(job.URL); err == nil {
+				activeBaselines = baselinesForHost(reqCtx, cfg, client, hostCalibCache, u.Host)
 			}
-			// Plain recursion
-			newJob := Job{
-				URL:      job.URL,
-				PostData: replacePlaceholder(cfg.PostData, job.URL),
-				Depth:    job.Depth + 1,
+		}
+		if matchesBaseline(resp.StatusCode, length, words, lines, activeBaselines) {
+			return
+		}
+	}
+
+	if replayClient != nil {
+		replayRequest(reqCtx, replayClient, cfg, job)
+	}
+
+	results <- Result{
+		URL:        job.URL,
+		StatusCode: resp.StatusCode,
+		Length:     length,
+		Words:      words,
+		Lines:      lines,
+		DurationMs: duration.Milliseconds(),
+	}
+
+	// Recursive fuzzing
+	if cfg.Recursive && job.Depth < cfg.MaxDepth {
+		for _, ext := range cfg.Extensions {
+			values := cloneValues(job.Values)
+			values["FUZZ"] = job.URL + ext
+			if !sendJob(ctx, jobs, buildJob(cfg, values, job.Depth+1)) {
+				return
 			}
-			jobs <- newJob
 		}
+		// Plain recursion
+		values := cloneValues(job.Values)
+		sendJob(ctx, jobs, Job{
+			URL:      job.URL,
+			PostData: replacePlaceholders(cfg.PostData, values),
+			Depth:    job.Depth + 1,
+			Values:   values,
+		})
 	}
 }
 
-// Colored output helper
-func printResult(res Result, jsonOutput bool) {
-	if jsonOutput {
-		data, _ := json.Marshal(res)
-		fmt.Println(string(data))
+// replayRequest re-issues a request that passed all filters through the configured
+// replay proxy so it can be triaged interactively; any response is discarded.
+func replayRequest(ctx context.Context, client *http.Client, cfg *Config, job Job) {
+	var bodyReader io.Reader
+	if job.PostData != "" {
+		bodyReader = strings.NewReader(job.PostData)
+	}
+	req, err := http.NewRequestWithContext(ctx, cfg.Method, job.URL, bodyReader)
+	if err != nil {
+		return
+	}
+	for k, v := range cfg.Headers {
+		req.Header.Set(k, replacePlaceholders(v, job.Values))
+	}
+	resp, err := client.Do(req)
+	if err != nil {
 		return
 	}
+	io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+}
+
+// parseRequestFile parses a raw HTTP request (as saved from Burp/ZAP) into its method,
+// a full URL template, headers, and body, so it can be replayed with FUZZ keywords intact.
+func parseRequestFile(path, proto string) (method, urlTemplate string, headers map[string]string, body string, err error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", "", nil, "", err
+	}
+
+	raw := strings.ReplaceAll(string(data), "\r\n", "\n")
+	parts := strings.SplitN(raw, "\n\n", 2)
+	if len(parts) == 2 {
+		body = parts[1]
+	}
 
-	var color string
+	lines := strings.Split(parts[0], "\n")
+	requestLine := strings.Fields(lines[0])
+	if len(requestLine) < 2 {
+		return "", "", nil, "", fmt.Errorf("invalid request line: %q", lines[0])
+	}
+	method = requestLine[0]
+	requestPath := requestLine[1]
+
+	headers = make(map[string]string)
+	host := ""
+	for _, line := range lines[1:] {
+		kv := strings.SplitN(line, ":", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		key := strings.TrimSpace(kv[0])
+		val := strings.TrimSpace(kv[1])
+		headers[key] = val
+		if strings.EqualFold(key, "Host") {
+			host = val
+		}
+	}
+	if host == "" {
+		return "", "", nil, "", fmt.Errorf("request file missing Host header")
+	}
+
+	if proto == "" {
+		proto = "https"
+	}
+	urlTemplate = proto + "://" + host + requestPath
+	return method, urlTemplate, headers, body, nil
+}
+
+// statusColor returns the ANSI color code for a status code, for console output.
+func statusColor(statusCode int) string {
 	switch {
-	case res.StatusCode >= 200 && res.StatusCode < 300:
-		color = "\033[32m" // green
-	case res.StatusCode >= 300 && res.StatusCode < 400:
-		color = "\033[36m" // cyan
-	case res.StatusCode >= 400 && res.StatusCode < 500:
-		color = "\033[33m" // yellow
-	case res.StatusCode >= 500:
-		color = "\033[31m" // red
+	case statusCode >= 200 && statusCode < 300:
+		return "\033[32m" // green
+	case statusCode >= 300 && statusCode < 400:
+		return "\033[36m" // cyan
+	case statusCode >= 400 && statusCode < 500:
+		return "\033[33m" // yellow
+	case statusCode >= 500:
+		return "\033[31m" // red
 	default:
-		color = "\033[0m"
+		return "\033[0m"
 	}
+}
+
+// Outputter receives each passing Result as it arrives and flushes/closes any
+// underlying resource once fuzzing is done. Implementations must not block fan-in.
+type Outputter interface {
+	Write(res Result) error
+	Close() error
+}
 
+// consoleOutputter is the default: colored, human-readable lines to stdout.
+type consoleOutputter struct{}
+
+func (consoleOutputter) Write(res Result) error {
 	if res.Error != "" {
 		fmt.Printf("\033[35m[ERROR]\033[0m %s -> %s\n", res.URL, res.Error)
-	} else {
-		fmt.Printf("%s%s\033[0m\t%d\t%d\n", color, res.URL, res.StatusCode, res.Length)
+		return nil
+	}
+	fmt.Printf("%s%s\033[0m\t%d\t%d\n", statusColor(res.StatusCode), res.URL, res.StatusCode, res.Length)
+	return nil
+}
+
+func (consoleOutputter) Close() error { return nil }
+
+// jsonOutputter writes a single JSON array of all results.
+type jsonOutputter struct {
+	w     io.Writer
+	wc    io.Closer
+	first bool
+}
+
+func newJSONOutputter(w io.Writer, wc io.Closer) *jsonOutputter {
+	fmt.Fprint(w, "[")
+	return &jsonOutputter{w: w, wc: wc, first: true}
+}
+
+func (o *jsonOutputter) Write(res Result) error {
+	if !o.first {
+		fmt.Fprint(o.w, ",")
+	}
+	o.first = false
+	data, err := json.Marshal(res)
+	if err != nil {
+		return err
+	}
+	_, err = o.w.Write(data)
+	return err
+}
+
+func (o *jsonOutputter) Close() error {
+	fmt.Fprint(o.w, "]\n")
+	if o.wc != nil {
+		return o.wc.Close()
+	}
+	return nil
+}
+
+// ndjsonOutputter writes one JSON object per line, so output can be streamed/tailed.
+type ndjsonOutputter struct {
+	w  io.Writer
+	wc io.Closer
+}
+
+func (o *ndjsonOutputter) Write(res Result) error {
+	data, err := json.Marshal(res)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(o.w, "%s\n", data)
+	return err
+}
+
+func (o *ndjsonOutputter) Close() error {
+	if o.wc != nil {
+		return o.wc.Close()
+	}
+	return nil
+}
+
+// csvOutputter writes results as CSV, with a header row written up front.
+type csvOutputter struct {
+	cw *csv.Writer
+	wc io.Closer
+}
+
+func newCSVOutputter(w io.Writer, wc io.Closer) (*csvOutputter, error) {
+	o := &csvOutputter{cw: csv.NewWriter(w), wc: wc}
+	if err := o.cw.Write([]string{"url", "status_code", "length", "words", "lines", "duration_ms", "error"}); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+func (o *csvOutputter) Write(res Result) error {
+	return o.cw.Write([]string{
+		res.URL,
+		strconv.Itoa(res.StatusCode),
+		strconv.Itoa(res.Length),
+		strconv.Itoa(res.Words),
+		strconv.Itoa(res.Lines),
+		strconv.FormatInt(res.DurationMs, 10),
+		res.Error,
+	})
+}
+
+func (o *csvOutputter) Close() error {
+	o.cw.Flush()
+	if err := o.cw.Error(); err != nil {
+		return err
+	}
+	if o.wc != nil {
+		return o.wc.Close()
+	}
+	return nil
+}
+
+// mdOutputter writes a Markdown table, handy for pasting into a PR or ticket.
+type mdOutputter struct {
+	w           io.Writer
+	wc          io.Closer
+	wroteHeader bool
+}
+
+func (o *mdOutputter) Write(res Result) error {
+	if !o.wroteHeader {
+		fmt.Fprintln(o.w, "| URL | Status | Size | Words | Lines | Duration (ms) | Error |")
+		fmt.Fprintln(o.w, "|---|---|---|---|---|---|---|")
+		o.wroteHeader = true
+	}
+	_, err := fmt.Fprintf(o.w, "| %s | %d | %d | %d | %d | %d | %s |\n",
+		res.URL, res.StatusCode, res.Length, res.Words, res.Lines, res.DurationMs, res.Error)
+	return err
+}
+
+func (o *mdOutputter) Close() error {
+	if o.wc != nil {
+		return o.wc.Close()
+	}
+	return nil
+}
+
+// htmlOutputter buffers every result and renders a single self-contained HTML
+// report (sortable/searchable/paginated table) once fuzzing finishes.
+type htmlOutputter struct {
+	w       io.Writer
+	wc      io.Closer
+	results []Result
+}
+
+func (o *htmlOutputter) Write(res Result) error {
+	o.results = append(o.results, res)
+	return nil
+}
+
+func (o *htmlOutputter) Close() error {
+	data, err := json.Marshal(o.results)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(o.w, htmlReportTemplate, string(data)); err != nil {
+		return err
+	}
+	if o.wc != nil {
+		return o.wc.Close()
+	}
+	return nil
+}
+
+// htmlReportTemplate is a self-contained report: the %s is the JSON-encoded result set.
+const htmlReportTemplate = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>GoFuzz report</title>
+<style>
+  body { font-family: -apple-system, sans-serif; margin: 2rem; background: #111; color: #eee; }
+  input, select { padding: .4rem; margin-bottom: 1rem; }
+  table { border-collapse: collapse; width: 100%%; }
+  th, td { border: 1px solid #333; padding: .4rem .6rem; text-align: left; }
+  th { cursor: pointer; background: #1c1c1c; }
+  tr:nth-child(even) { background: #1a1a1a; }
+  .s2xx { color: #4caf50; } .s3xx { color: #29b6f6; } .s4xx { color: #ffca28; } .s5xx { color: #ef5350; }
+</style>
+</head>
+<body>
+<h1>GoFuzz report</h1>
+<input id="search" placeholder="Filter by URL...">
+<select id="pageSize">
+  <option>25</option><option>50</option><option selected>100</option><option>500</option>
+</select>
+<button id="prev">Prev</button> <span id="pageInfo"></span> <button id="next">Next</button>
+<table id="results">
+  <thead><tr>
+    <th data-key="url">URL</th>
+    <th data-key="status_code">Status</th>
+    <th data-key="length">Size</th>
+    <th data-key="words">Words</th>
+    <th data-key="lines">Lines</th>
+    <th data-key="duration_ms">Duration (ms)</th>
+  </tr></thead>
+  <tbody></tbody>
+</table>
+<script>
+  const data = %s;
+  let sortKey = null, sortAsc = true, page = 0;
+
+  function statusClass(code) {
+    if (code >= 200 && code < 300) return "s2xx";
+    if (code >= 300 && code < 400) return "s3xx";
+    if (code >= 400 && code < 500) return "s4xx";
+    if (code >= 500) return "s5xx";
+    return "";
+  }
+
+  function render() {
+    const q = document.getElementById("search").value.toLowerCase();
+    const pageSize = parseInt(document.getElementById("pageSize").value, 10);
+    let rows = data.filter(r => r.url.toLowerCase().includes(q));
+    if (sortKey) {
+      rows = rows.slice().sort((a, b) => {
+        const av = a[sortKey], bv = b[sortKey];
+        if (av < bv) return sortAsc ? -1 : 1;
+        if (av > bv) return sortAsc ? 1 : -1;
+        return 0;
+      });
+    }
+    const pages = Math.max(1, Math.ceil(rows.length / pageSize));
+    page = Math.min(Math.max(page, 0), pages - 1);
+    document.getElementById("pageInfo").textContent = "Page " + (page + 1) + " of " + pages;
+    rows = rows.slice(page * pageSize, (page + 1) * pageSize);
+    const tbody = document.querySelector("#results tbody");
+    tbody.textContent = "";
+    for (const r of rows) {
+      const tr = document.createElement("tr");
+      const statusTd = document.createElement("td");
+      statusTd.className = statusClass(r.status_code);
+      statusTd.textContent = r.status_code;
+      for (const text of [r.url]) {
+        const td = document.createElement("td");
+        td.textContent = text;
+        tr.appendChild(td);
+      }
+      tr.appendChild(statusTd);
+      for (const text of [r.length, r.words, r.lines, r.duration_ms]) {
+        const td = document.createElement("td");
+        td.textContent = text;
+        tr.appendChild(td);
+      }
+      tbody.appendChild(tr);
+    }
+  }
+
+  document.getElementById("search").addEventListener("input", () => { page = 0; render(); });
+  document.getElementById("pageSize").addEventListener("change", () => { page = 0; render(); });
+  document.getElementById("prev").addEventListener("click", () => { page--; render(); });
+  document.getElementById("next").addEventListener("click", () => { page++; render(); });
+  document.querySelectorAll("th[data-key]").forEach(th => {
+    th.addEventListener("click", () => {
+      const key = th.dataset.key;
+      sortAsc = sortKey === key ? !sortAsc : true;
+      sortKey = key;
+      render();
+    });
+  });
+  render();
+</script>
+</body>
+</html>
+`
+
+// buildJob resolves a job's URL and POST data from the current keyword bindings.
+func buildJob(cfg *Config, values map[string]string, depth int) Job {
+	return Job{
+		URL:      replacePlaceholders(cfg.URLTemplate, values),
+		PostData: replacePlaceholders(cfg.PostData, values),
+		Depth:    depth,
+		Values:   values,
+	}
+}
+
+// emitWithExtensions queues job for values, plus one extra job per -e extension appended
+// to the FUZZ keyword's word, matching the original single-wordlist extension behavior.
+// It returns false once ctx is cancelled (e.g. -maxtime expired), so callers can stop feeding.
+func emitWithExtensions(ctx context.Context, cfg *Config, jobs chan Job, values map[string]string) bool {
+	if !sendJob(ctx, jobs, buildJob(cfg, values, 0)) {
+		return false
+	}
+	if word, ok := values["FUZZ"]; ok {
+		for _, ext := range cfg.Extensions {
+			extValues := cloneValues(values)
+			extValues["FUZZ"] = word + ext
+			if !sendJob(ctx, jobs, buildJob(cfg, extValues, 0)) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// readWordlistLines reads a wordlist file into memory, skipping blank lines.
+func readWordlistLines(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var words []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if word := scanner.Text(); word != "" {
+			words = append(words, word)
+		}
+	}
+	return words, scanner.Err()
+}
+
+// feedSniper streams the single bound wordlist, the original single-keyword behavior.
+func feedSniper(ctx context.Context, cfg *Config, jobs chan Job) error {
+	binding := cfg.Wordlists[0]
+	file, err := os.Open(binding.Path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		word := scanner.Text()
+		if word == "" {
+			continue
+		}
+		if !emitWithExtensions(ctx, cfg, jobs, map[string]string{binding.Keyword: word}) {
+			return nil
+		}
+	}
+	return scanner.Err()
+}
+
+// feedPitchfork zips the bound wordlists together, stopping at the shortest one.
+func feedPitchfork(ctx context.Context, cfg *Config, jobs chan Job) error {
+	wordlists := make([][]string, len(cfg.Wordlists))
+	minLen := -1
+	for i, binding := range cfg.Wordlists {
+		words, err := readWordlistLines(binding.Path)
+		if err != nil {
+			return err
+		}
+		wordlists[i] = words
+		if minLen == -1 || len(words) < minLen {
+			minLen = len(words)
+		}
+	}
+
+	for i := 0; i < minLen; i++ {
+		values := make(map[string]string, len(cfg.Wordlists))
+		for j, binding := range cfg.Wordlists {
+			values[binding.Keyword] = wordlists[j][i]
+		}
+		if !emitWithExtensions(ctx, cfg, jobs, values) {
+			return nil
+		}
+	}
+	return nil
+}
+
+// feedClusterbomb emits the Cartesian product of all bound wordlists.
+func feedClusterbomb(ctx context.Context, cfg *Config, jobs chan Job) error {
+	wordlists := make([][]string, len(cfg.Wordlists))
+	for i, binding := range cfg.Wordlists {
+		words, err := readWordlistLines(binding.Path)
+		if err != nil {
+			return err
+		}
+		wordlists[i] = words
+	}
+
+	var recurse func(i int, values map[string]string) bool
+	recurse = func(i int, values map[string]string) bool {
+		if i == len(cfg.Wordlists) {
+			return emitWithExtensions(ctx, cfg, jobs, cloneValues(values))
+		}
+		for _, word := range wordlists[i] {
+			values[cfg.Wordlists[i].Keyword] = word
+			if !recurse(i+1, values) {
+				return false
+			}
+		}
+		return true
+	}
+	recurse(0, make(map[string]string, len(cfg.Wordlists)))
+	return nil
+}
+
+// feedJobs generates the initial jobs according to cfg.Mode.
+func feedJobs(ctx context.Context, cfg *Config, jobs chan Job) error {
+	switch cfg.Mode {
+	case ModeSniper:
+		return feedSniper(ctx, cfg, jobs)
+	case ModePitchfork:
+		return feedPitchfork(ctx, cfg, jobs)
+	case ModeClusterbomb:
+		return feedClusterbomb(ctx, cfg, jobs)
+	default:
+		return fmt.Errorf("unknown mode %q", cfg.Mode)
+	}
+}
+
+// openOutput opens the destination for one output format. With an empty path, results
+// stream to stdout; with "all", each format gets its own path.<format> file.
+func openOutput(path, format string, multiFormat bool) (io.Writer, io.Closer, error) {
+	if path == "" {
+		return os.Stdout, nil, nil
+	}
+	target := path
+	if multiFormat {
+		target = path + "." + format
+	}
+	file, err := os.Create(target)
+	if err != nil {
+		return nil, nil, err
+	}
+	return file, file, nil
+}
+
+// buildOutputters constructs the Outputter(s) for cfg.OutputFormat/cfg.OutputPath.
+// An empty OutputFormat falls back to the original colored console output.
+func buildOutputters(cfg *Config) ([]Outputter, error) {
+	if cfg.OutputFormat == "" {
+		return []Outputter{consoleOutputter{}}, nil
+	}
+
+	formats := []string{cfg.OutputFormat}
+	multiFormat := cfg.OutputFormat == "all"
+	if multiFormat {
+		formats = []string{"json", "ndjson", "csv", "html", "md"}
+	}
+	if multiFormat && cfg.OutputPath == "" {
+		return nil, fmt.Errorf("-o is required when using -of all")
+	}
+
+	var outputters []Outputter
+	for _, format := range formats {
+		w, closer, err := openOutput(cfg.OutputPath, format, multiFormat)
+		if err != nil {
+			return nil, err
+		}
+		switch format {
+		case "json":
+			outputters = append(outputters, newJSONOutputter(w, closer))
+		case "ndjson":
+			outputters = append(outputters, &ndjsonOutputter{w: w, wc: closer})
+		case "csv":
+			csvOut, err := newCSVOutputter(w, closer)
+			if err != nil {
+				return nil, err
+			}
+			outputters = append(outputters, csvOut)
+		case "md":
+			outputters = append(outputters, &mdOutputter{w: w, wc: closer})
+		case "html":
+			outputters = append(outputters, &htmlOutputter{w: w, wc: closer})
+		default:
+			return nil, fmt.Errorf("unknown output format %q", format)
+		}
 	}
+	return outputters, nil
 }
 
 func main() {
 	cfg := &Config{}
 
 	flag.StringVar(&cfg.URLTemplate, "u", "", "URL template with FUZZ placeholder")
-	flag.StringVar(&cfg.Wordlist, "w", "", "Wordlist file")
+	var wordlistFlags wordlistFlag
+	flag.Var(&wordlistFlags, "w", "Wordlist file, optionally bound to a keyword as path:KEYWORD (repeatable)")
+	modeStr := flag.String("mode", ModeSniper, "Iteration mode across multiple wordlists: sniper, pitchfork, or clusterbomb")
 	flag.StringVar(&cfg.Method, "X", "GET", "HTTP method")
 	headerStr := flag.String("H", "", "Headers comma-separated, e.g., 'User-Agent: FUZZ'")
 	extStr := flag.String("e", "", "Extensions comma-separated, e.g., .php,.html")
-	statusStr := flag.String("s", "", "Status codes to filter comma-separated, e.g., 200,301")
-	regexStr := flag.String("r", "", "Regex filter for response body")
 	flag.IntVar(&cfg.Workers, "t", 10, "Number of concurrent workers")
-	flag.IntVar(&cfg.MinLength, "min", 0, "Minimum response length")
-	flag.IntVar(&cfg.MaxLength, "max", 0, "Maximum response length")
 	flag.StringVar(&cfg.PostData, "d", "", "POST data")
 	flag.BoolVar(&cfg.FollowRedirects, "f", true, "Follow redirects")
 	flag.DurationVar(&cfg.Timeout, "timeout", 10*time.Second, "Request timeout")
@@ -202,17 +1126,72 @@ func main() {
 	flag.BoolVar(&cfg.UseTor, "tor", false, "Use Tor SOCKS5 proxy on 127.0.0.1:9050")
 	flag.BoolVar(&cfg.Recursive, "rec", false, "Enable recursive fuzzing")
 	flag.IntVar(&cfg.MaxDepth, "depth", 2, "Maximum recursion depth")
-	flag.BoolVar(&cfg.JSONOutput, "json", false, "Output results in JSON")
+	flag.StringVar(&cfg.OutputFormat, "of", "", "Output format: json, ndjson, csv, html, md, or all (default: colored console output)")
+	flag.StringVar(&cfg.OutputPath, "o", "", "Output file path (required with -of all)")
 	flag.IntVar(&cfg.Retries, "retries", 1, "Number of retries for failed requests")
+	flag.BoolVar(&cfg.AutoCalibrate, "ac", false, "Enable autocalibration to filter wildcard/soft-404 responses")
+	flag.IntVar(&cfg.AutoCalibrateCount, "acc", 5, "Number of requests to use for autocalibration")
+	flag.BoolVar(&cfg.AutoCalibratePerHost, "ach", false, "Perform autocalibration separately for each host")
+	acStr := flag.String("acs", "", "Custom calibration strings comma-separated, used instead of random words")
+	requestFile := flag.String("request", "", "Raw HTTP request file (e.g. saved from Burp/ZAP) with FUZZ placeholders")
+	requestProto := flag.String("request-proto", "https", "Protocol to use when building the URL from -request")
+	flag.DurationVar(&cfg.MaxRunTime, "maxtime", 0, "Kill the entire run after this long, e.g. 5m; 0 disables")
+	flag.DurationVar(&cfg.MaxJobTime, "maxtime-job", 0, "Kill an individual job's request after this long; 0 disables")
+	flag.StringVar(&cfg.ReplayProxy, "replay-proxy", "", "Re-send requests that pass all filters through this proxy URL (e.g. Burp/ZAP) for manual triage")
+
+	mcStr := flag.String("mc", "", "Match response status codes, comma-separated (e.g. 200,301-302)")
+	mlStr := flag.String("ml", "", "Match response line counts, comma-separated")
+	mwStr := flag.String("mw", "", "Match response word counts, comma-separated")
+	msStr := flag.String("ms", "", "Match response sizes, comma-separated")
+	mrStr := flag.String("mr", "", "Match responses whose body matches this regex")
+	flag.DurationVar(&cfg.Matchers.MinTime, "mt", 0, "Match responses that took at least this long, e.g. 200ms")
+	flag.StringVar(&cfg.MatchMode, "mmode", "and", "How to combine -mc/-ml/-mw/-ms/-mr/-mt: and or or")
+
+	fcStr := flag.String("fc", "", "Filter out response status codes, comma-separated")
+	flStr := flag.String("fl", "", "Filter out response line counts, comma-separated")
+	fwStr := flag.String("fw", "", "Filter out response word counts, comma-separated")
+	fsStr := flag.String("fs", "", "Filter out response sizes, comma-separated")
+	frStr := flag.String("fr", "", "Filter out responses whose body matches this regex")
+	flag.DurationVar(&cfg.Filters.MinTime, "ft", 0, "Filter out responses that took at least this long, e.g. 2s")
+	flag.StringVar(&cfg.FilterMode, "fmode", "and", "How to combine -fc/-fl/-fw/-fs/-fr/-ft: and or or")
 	flag.Parse()
 
-	if cfg.URLTemplate == "" || cfg.Wordlist == "" {
-		fmt.Println("Usage: gofuzzyourself -u <url> -w <wordlist> [options]")
+	if *requestFile != "" {
+		method, urlTemplate, headers, body, err := parseRequestFile(*requestFile, *requestProto)
+		if err != nil {
+			fmt.Println("Error parsing request file:", err)
+			os.Exit(1)
+		}
+		cfg.Method = method
+		cfg.URLTemplate = urlTemplate
+		cfg.Headers = headers
+		cfg.PostData = body
+	}
+
+	cfg.Wordlists = []WordlistBinding(wordlistFlags)
+	cfg.Mode = *modeStr
+
+	if cfg.URLTemplate == "" || len(cfg.Wordlists) == 0 {
+		fmt.Println("Usage: gofuzzyourself -u <url> -w <wordlist[:KEYWORD]> [options]")
+		os.Exit(1)
+	}
+	if cfg.Mode != ModeSniper && cfg.Mode != ModePitchfork && cfg.Mode != ModeClusterbomb {
+		fmt.Println("Invalid -mode:", cfg.Mode)
+		os.Exit(1)
+	}
+	if cfg.Mode == ModeSniper && len(cfg.Wordlists) != 1 {
+		fmt.Println("sniper mode requires exactly one -w wordlist; use pitchfork or clusterbomb for multiple")
+		os.Exit(1)
+	}
+	if cfg.AutoCalibrateCount <= 0 {
+		fmt.Println("Invalid -acc:", cfg.AutoCalibrateCount, "(must be > 0)")
 		os.Exit(1)
 	}
 
 	// Headers
-	cfg.Headers = make(map[string]string)
+	if cfg.Headers == nil {
+		cfg.Headers = make(map[string]string)
+	}
 	if *headerStr != "" {
 		for _, h := range strings.Split(*headerStr, ",") {
 			parts := strings.SplitN(h, ":", 2)
@@ -227,23 +1206,36 @@ func main() {
 		cfg.Extensions = strings.Split(*extStr, ",")
 	}
 
-	// Status codes
-	if *statusStr != "" {
-		for _, s := range strings.Split(*statusStr, ",") {
-			if code, err := strconv.Atoi(strings.TrimSpace(s)); err == nil {
-				cfg.StatusFilter = append(cfg.StatusFilter, code)
-			}
-		}
+	// Matchers / filters
+	var err error
+	if cfg.Matchers, err = parseFilterSpec(*mcStr, *msStr, *mwStr, *mlStr, *mrStr, cfg.Matchers.MinTime); err != nil {
+		fmt.Println("Invalid matcher:", err)
+		os.Exit(1)
+	}
+	if cfg.Filters, err = parseFilterSpec(*fcStr, *fsStr, *fwStr, *flStr, *frStr, cfg.Filters.MinTime); err != nil {
+		fmt.Println("Invalid filter:", err)
+		os.Exit(1)
+	}
+	if cfg.MatchMode != "and" && cfg.MatchMode != "or" {
+		fmt.Println("Invalid -mmode:", cfg.MatchMode)
+		os.Exit(1)
+	}
+	if cfg.FilterMode != "and" && cfg.FilterMode != "or" {
+		fmt.Println("Invalid -fmode:", cfg.FilterMode)
+		os.Exit(1)
+	}
+	switch cfg.OutputFormat {
+	case "", "json", "ndjson", "csv", "html", "md", "all":
+	default:
+		fmt.Println("Invalid -of:", cfg.OutputFormat)
+		os.Exit(1)
 	}
 
-	// Regex
-	if *regexStr != "" {
-		re, err := regexp.Compile(*regexStr)
-		if err != nil {
-			fmt.Println("Invalid regex:", err)
-			os.Exit(1)
+	// Autocalibration strings
+	if *acStr != "" {
+		for _, s := range strings.Split(*acStr, ",") {
+			cfg.AutoCalibrateStrings = append(cfg.AutoCalibrateStrings, strings.TrimSpace(s))
 		}
-		cfg.RegexFilter = re
 	}
 
 	// HTTP client
@@ -271,6 +1263,39 @@ func main() {
 		}
 	}
 
+	// Replay client: re-issues requests that pass all filters through an external
+	// proxy (e.g. Burp/ZAP) so the user can triage hits interactively.
+	var replayClient *http.Client
+	if cfg.ReplayProxy != "" {
+		replayProxyURL, err := url.Parse(cfg.ReplayProxy)
+		if err != nil {
+			fmt.Println("Invalid -replay-proxy:", err)
+			os.Exit(1)
+		}
+		replayClient = &http.Client{
+			Timeout: cfg.Timeout,
+			Transport: &http.Transport{
+				TLSClientConfig: &tls.Config{InsecureSkipVerify: cfg.SkipTLSVerify},
+				Proxy:           http.ProxyURL(replayProxyURL),
+			},
+		}
+	}
+
+	// Autocalibration
+	var baselines []Baseline
+	hostCalibCache := &sync.Map{}
+	if cfg.AutoCalibrate && !cfg.AutoCalibratePerHost {
+		baselines = calibrate(context.Background(), cfg, client, cfg.URLTemplate)
+	}
+
+	// Overall run budget; cancelling ctx tells workers and feeders to drain and stop.
+	ctx := context.Background()
+	cancel := func() {}
+	if cfg.MaxRunTime > 0 {
+		ctx, cancel = context.WithTimeout(ctx, cfg.MaxRunTime)
+	}
+	defer cancel()
+
 	// Channels
 	jobs := make(chan Job, cfg.Workers*2)
 	results := make(chan Result, cfg.Workers*2)
@@ -280,38 +1305,41 @@ func main() {
 	// Start workers
 	for i := 0; i < cfg.Workers; i++ {
 		wg.Add(1)
-		go worker(jobs, results, cfg, client, &wg, &visited)
+		go worker(jobs, results, cfg, client, replayClient, &wg, &visited, baselines, hostCalibCache, ctx)
 	}
 
-	// Result printer
+	outputters, err := buildOutputters(cfg)
+	if err != nil {
+		fmt.Println("Error setting up output:", err)
+		os.Exit(1)
+	}
+
+	// Fan-in: dispatch every passing Result to all active outputters, then flush/close them.
+	outputDone := make(chan struct{})
 	go func() {
+		defer close(outputDone)
 		for res := range results {
-			printResult(res, cfg.JSONOutput)
+			for _, o := range outputters {
+				if err := o.Write(res); err != nil {
+					fmt.Fprintln(os.Stderr, "Error writing output:", err)
+				}
+			}
+		}
+		for _, o := range outputters {
+			if err := o.Close(); err != nil {
+				fmt.Fprintln(os.Stderr, "Error closing output:", err)
+			}
 		}
 	}()
 
-	// Feed initial jobs
-	file, err := os.Open(cfg.Wordlist)
-	if err != nil {
-		fmt.Println("Error opening wordlist:", err)
+	// Feed initial jobs according to the selected iteration mode
+	if err := feedJobs(ctx, cfg, jobs); err != nil {
+		fmt.Println("Error feeding jobs:", err)
 		os.Exit(1)
 	}
-	defer file.Close()
-	scanner := bufio.NewScanner(file)
-	for scanner.Scan() {
-		word := scanner.Text()
-		if word == "" {
-			continue
-		}
-		// Base
-		jobs <- Job{URL: replacePlaceholder(cfg.URLTemplate, word), PostData: replacePlaceholder(cfg.PostData, word), Depth: 0}
-		// With extensions
-		for _, ext := range cfg.Extensions {
-			jobs <- Job{URL: replacePlaceholder(cfg.URLTemplate, word+ext), PostData: replacePlaceholder(cfg.PostData, word+ext), Depth: 0}
-		}
-	}
 
 	close(jobs)
 	wg.Wait()
 	close(results)
+	<-outputDone
 }